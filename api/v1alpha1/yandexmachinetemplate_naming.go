@@ -0,0 +1,134 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultNamingStrategyTemplate is used when a YandexMachineTemplate does not specify
+// spec.namingStrategy.template.
+const DefaultNamingStrategyTemplate = "{{ .machine.name }}-{{ .random }}"
+
+// generatedNameRegex matches the RFC 1123 label subset Yandex Cloud accepts for resource names.
+var generatedNameRegex = regexp.MustCompile("^[a-z]([-a-z0-9]*[a-z0-9])?$")
+
+// MaxGeneratedNameLength is the maximum length Yandex Cloud allows for a resource name.
+const MaxGeneratedNameLength = 63
+
+// MaxKubernetesNameLength is the maximum length Kubernetes allows for an object name
+// (RFC 1123 subdomain), i.e. the longest .machine.name/.machineSet.name/.cluster.name a
+// NamingStrategy template could ever be rendered against.
+const MaxKubernetesNameLength = 253
+
+// RandomSuffixLength is the length of the random suffix the controller generates when
+// rendering a NamingStrategy template at YandexMachine creation time.
+const RandomSuffixLength = 5
+
+// namingStrategyFuncs returns the helper functions available to a NamingStrategy template.
+func namingStrategyFuncs() template.FuncMap {
+	return template.FuncMap{
+		"trunc": func(n int, s string) string {
+			if n < 0 || n >= len(s) {
+				return s
+			}
+			return s[:n]
+		},
+		"trimSuffix": func(suffix, s string) string {
+			return strings.TrimSuffix(s, suffix)
+		},
+		"lower": strings.ToLower,
+	}
+}
+
+// GenerateName renders tmpl against the machine/machineSet/cluster names and a random suffix,
+// returning the resulting YandexMachine name.
+func GenerateName(tmpl, machineName, machineSetName, clusterName, random string) (string, error) {
+	parsed, err := template.New("namingStrategy").Funcs(namingStrategyFuncs()).Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", errors.Wrap(err, "parsing naming strategy template")
+	}
+
+	data := map[string]interface{}{
+		"machine":    map[string]interface{}{"name": machineName},
+		"machineSet": map[string]interface{}{"name": machineSetName},
+		"cluster":    map[string]interface{}{"name": clusterName},
+		"random":     random,
+	}
+
+	var buf bytes.Buffer
+	if err := parsed.Execute(&buf, data); err != nil {
+		return "", errors.Wrap(err, "rendering naming strategy template")
+	}
+
+	return buf.String(), nil
+}
+
+// GenerateMachineName renders this template's NamingStrategy (falling back to
+// DefaultNamingStrategyTemplate when none is set) against the given machine/machineSet/cluster
+// names and a random suffix, and validates the result. This is the entry point the YandexMachine-
+// creating controller calls to name a child YandexMachine.
+func (s *YandexMachineTemplateSpec) GenerateMachineName(machineName, machineSetName, clusterName, random string) (string, error) {
+	tmpl := DefaultNamingStrategyTemplate
+	if s.NamingStrategy != nil && s.NamingStrategy.Template != nil {
+		tmpl = *s.NamingStrategy.Template
+	}
+
+	name, err := GenerateName(tmpl, machineName, machineSetName, clusterName, random)
+	if err != nil {
+		return "", err
+	}
+
+	if err := ValidateGeneratedName(name); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// ValidateNamingStrategyTemplate checks that tmpl parses cleanly and, critically, that it still
+// produces a valid Yandex Cloud resource name when rendered against the longest machine/machineSet/
+// cluster names Kubernetes allows (MaxKubernetesNameLength) and the random suffix length the
+// controller actually generates (RandomSuffixLength). Probing with short example names instead
+// would pass templates that only exceed MaxGeneratedNameLength for real, longer object names.
+func ValidateNamingStrategyTemplate(tmpl string) error {
+	longestName := strings.Repeat("a", MaxKubernetesNameLength)
+	random := strings.Repeat("a", RandomSuffixLength)
+
+	name, err := GenerateName(tmpl, longestName, longestName, longestName, random)
+	if err != nil {
+		return err
+	}
+
+	return ValidateGeneratedName(name)
+}
+
+// ValidateGeneratedName returns an error describing why name is not a valid Yandex Cloud resource name.
+func ValidateGeneratedName(name string) error {
+	if len(name) == 0 || len(name) > MaxGeneratedNameLength {
+		return errors.Errorf("generated name %q must be between 1 and %d characters", name, MaxGeneratedNameLength)
+	}
+	if !generatedNameRegex.MatchString(name) {
+		return errors.Errorf("generated name %q must start with a lowercase letter and contain only lowercase letters, digits and hyphens", name)
+	}
+	return nil
+}
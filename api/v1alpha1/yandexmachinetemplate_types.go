@@ -0,0 +1,136 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// YandexMachineTemplateSpec defines the desired state of YandexMachineTemplate.
+type YandexMachineTemplateSpec struct {
+	Template YandexMachineTemplateResource `json:"template"`
+
+	// NamingStrategy allows configuring the generated name of the YandexMachine.
+	// +optional
+	NamingStrategy *NamingStrategy `json:"namingStrategy,omitempty"`
+}
+
+// NamingStrategy defines how the name of the generated YandexMachine should be rendered.
+type NamingStrategy struct {
+	// Template defines the template to use for generating the name of the YandexMachine object.
+	// The template allows the following variables: `.machine.name`, `.machineSet.name`, `.cluster.name`, `.random`.
+	// The templating also allows for the following functions: `trimSuffix`, `trunc`, and `lower`.
+	// The generated name must be a valid Kubernetes/Yandex Cloud resource name (RFC 1123, max 63 characters).
+	// If not defined, it will fall back to `{{ .machine.name }}-{{ .random }}`.
+	// +optional
+	Template *string `json:"template,omitempty"`
+}
+
+// YandexMachineTemplateResource describes the data needed to create a YandexMachine from a template.
+type YandexMachineTemplateResource struct {
+	// Spec is the specification of the desired behavior of the machine.
+	Spec YandexMachineSpec `json:"spec"`
+}
+
+// YandexMachineSpec defines the desired state of YandexMachine.
+type YandexMachineSpec struct {
+	// ProviderID is the identification ID of the associated Yandex Cloud VM instance.
+	// +optional
+	ProviderID *string `json:"providerID,omitempty"`
+
+	// PlatformID is the Yandex Cloud compute platform the instance will run on, e.g. "standard-v3".
+	PlatformID string `json:"platformId"`
+
+	// ZoneID is the Yandex Cloud availability zone the instance will be created in, e.g. "ru-central1-a".
+	ZoneID string `json:"zoneId"`
+
+	// ResourcesSpec describes the compute resources allocated to the instance.
+	ResourcesSpec ResourcesSpec `json:"resourcesSpec"`
+
+	// BootDiskSpec describes the boot disk attached to the instance.
+	BootDiskSpec BootDiskSpec `json:"bootDiskSpec"`
+
+	// SubnetID is the ID of the Yandex Cloud subnet the instance's network interface is attached to.
+	SubnetID string `json:"subnetId"`
+
+	// ImageID is the ID of the Yandex Cloud image used to create the instance's boot disk.
+	// +optional
+	ImageID string `json:"imageId,omitempty"`
+
+	// Metadata is attached to the instance and exposed to it via the Yandex Cloud metadata service.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ResourcesSpec describes the compute resources allocated to a YandexMachine.
+type ResourcesSpec struct {
+	// Memory is the amount of memory allocated to the instance, in bytes.
+	Memory int64 `json:"memory"`
+
+	// Cores is the number of CPU cores allocated to the instance.
+	Cores int64 `json:"cores"`
+
+	// CoreFraction is the baseline CPU performance, as a percentage, guaranteed for the instance.
+	// +optional
+	CoreFraction int64 `json:"coreFraction,omitempty"`
+}
+
+// BootDiskSpec describes the boot disk attached to a YandexMachine.
+type BootDiskSpec struct {
+	// DiskSpec describes the boot disk itself.
+	DiskSpec DiskSpec `json:"diskSpec"`
+}
+
+// DiskSpec describes a Yandex Cloud disk.
+type DiskSpec struct {
+	// TypeID is the Yandex Cloud disk type, e.g. "network-ssd".
+	TypeID string `json:"typeId"`
+
+	// Size is the size of the disk, in bytes.
+	// +optional
+	Size int64 `json:"size,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=yandexmachinetemplates,scope=Namespaced,categories=cluster-api
+// +kubebuilder:subresource:status
+
+// YandexMachineTemplate is the Schema for the yandexmachinetemplates API.
+type YandexMachineTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec YandexMachineTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// YandexMachineTemplateList contains a list of YandexMachineTemplate.
+type YandexMachineTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []YandexMachineTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(func(scheme *runtime.Scheme) error {
+		scheme.AddKnownTypes(GroupVersion, &YandexMachineTemplate{}, &YandexMachineTemplateList{})
+		metav1.AddToGroupVersion(scheme, GroupVersion)
+		return nil
+	})
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	infrav1 "github.com/shturval-tech/cluster-api-provider-yandex/api/v1alpha1"
+)
+
+// yandexIDRegex matches the opaque 20-character identifiers Yandex Cloud assigns to
+// resources such as subnets and images.
+var yandexIDRegex = regexp.MustCompile("^[a-z0-9]{20}$")
+
+// platformLimits describes the resource boundaries Yandex Cloud documents for a compute platform.
+type platformLimits struct {
+	minCores, maxCores   int64
+	minMemory, maxMemory int64 // bytes
+}
+
+const gib = int64(1) << 30
+
+// knownPlatformLimits holds the per-platform resource limits Yandex Cloud documents.
+// https://yandex.cloud/docs/compute/concepts/performance-levels
+var knownPlatformLimits = map[string]platformLimits{
+	"standard-v1": {minCores: 2, maxCores: 8, minMemory: 1 * gib, maxMemory: 32 * gib},
+	"standard-v2": {minCores: 2, maxCores: 32, minMemory: 1 * gib, maxMemory: 256 * gib},
+	"standard-v3": {minCores: 2, maxCores: 96, minMemory: 1 * gib, maxMemory: 768 * gib},
+}
+
+// knownCoreFractions are the guaranteed baseline vCPU performance percentages Yandex Cloud offers.
+var knownCoreFractions = map[int64]struct{}{5: {}, 20: {}, 50: {}, 100: {}}
+
+// knownZones are the Yandex Cloud availability zones this provider supports.
+var knownZones = map[string]struct{}{
+	"ru-central1-a": {},
+	"ru-central1-b": {},
+	"ru-central1-d": {},
+}
+
+// knownDiskTypes are the Yandex Cloud disk types this provider supports.
+var knownDiskTypes = map[string]struct{}{
+	"network-hdd":               {},
+	"network-ssd":               {},
+	"network-ssd-nonreplicated": {},
+	"network-ssd-io-m3":         {},
+}
+
+const (
+	maxMetadataKeyLength  = 128
+	maxMetadataTotalBytes = 256 * 1024
+)
+
+// validateYandexMachineSpec runs structured validation of spec against the constraints Yandex
+// Cloud documents for compute instances, returning one field.Invalid per violation.
+func validateYandexMachineSpec(spec infrav1.YandexMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	limits, knownPlatform := knownPlatformLimits[spec.PlatformID]
+	if !knownPlatform {
+		allErrs = append(allErrs, field.Invalid(path.Child("platformId"), spec.PlatformID, mustBeOneOf(supportedPlatformIDs())))
+	} else {
+		if spec.ResourcesSpec.Cores < limits.minCores || spec.ResourcesSpec.Cores > limits.maxCores {
+			allErrs = append(allErrs, field.Invalid(
+				path.Child("resourcesSpec", "cores"),
+				spec.ResourcesSpec.Cores,
+				fmt.Sprintf("must be between %d and %d for platform %q", limits.minCores, limits.maxCores, spec.PlatformID)),
+			)
+		}
+		if spec.ResourcesSpec.Memory < limits.minMemory || spec.ResourcesSpec.Memory > limits.maxMemory {
+			allErrs = append(allErrs, field.Invalid(
+				path.Child("resourcesSpec", "memory"),
+				spec.ResourcesSpec.Memory,
+				fmt.Sprintf("must be between %d and %d bytes for platform %q", limits.minMemory, limits.maxMemory, spec.PlatformID)),
+			)
+		}
+	}
+
+	if spec.ResourcesSpec.CoreFraction != 0 {
+		if _, ok := knownCoreFractions[spec.ResourcesSpec.CoreFraction]; !ok {
+			allErrs = append(allErrs, field.Invalid(path.Child("resourcesSpec", "coreFraction"), spec.ResourcesSpec.CoreFraction, mustBeOneOf(coreFractionValues())))
+		}
+	}
+
+	if _, ok := knownZones[spec.ZoneID]; !ok {
+		allErrs = append(allErrs, field.Invalid(path.Child("zoneId"), spec.ZoneID, mustBeOneOf(supportedZones())))
+	}
+
+	if _, ok := knownDiskTypes[spec.BootDiskSpec.DiskSpec.TypeID]; !ok {
+		allErrs = append(allErrs, field.Invalid(path.Child("bootDiskSpec", "diskSpec", "typeId"), spec.BootDiskSpec.DiskSpec.TypeID, mustBeOneOf(supportedDiskTypes())))
+	}
+
+	if !yandexIDRegex.MatchString(spec.SubnetID) {
+		allErrs = append(allErrs, field.Invalid(path.Child("subnetId"), spec.SubnetID, "must be a 20-character Yandex Cloud resource ID"))
+	}
+
+	if spec.ImageID != "" && !yandexIDRegex.MatchString(spec.ImageID) {
+		allErrs = append(allErrs, field.Invalid(path.Child("imageId"), spec.ImageID, "must be a 20-character Yandex Cloud resource ID"))
+	}
+
+	allErrs = append(allErrs, validateMetadata(spec.Metadata, path.Child("metadata"))...)
+
+	return allErrs
+}
+
+// validateMetadata enforces the per-key and total size limits Yandex Cloud places on instance metadata.
+func validateMetadata(metadata map[string]string, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var total int
+	for key, value := range metadata {
+		if len(key) > maxMetadataKeyLength {
+			allErrs = append(allErrs, field.Invalid(path.Key(key), key, fmt.Sprintf("key must not exceed %d characters", maxMetadataKeyLength)))
+		}
+		total += len(key) + len(value)
+	}
+
+	if total > maxMetadataTotalBytes {
+		allErrs = append(allErrs, field.Invalid(path, total, fmt.Sprintf("total metadata size must not exceed %d bytes", maxMetadataTotalBytes)))
+	}
+
+	return allErrs
+}
+
+func mustBeOneOf(values []string) string {
+	return "must be one of: " + strings.Join(values, ", ")
+}
+
+func supportedPlatformIDs() []string {
+	ids := make([]string, 0, len(knownPlatformLimits))
+	for id := range knownPlatformLimits {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func supportedZones() []string {
+	zones := make([]string, 0, len(knownZones))
+	for zone := range knownZones {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+func supportedDiskTypes() []string {
+	types := make([]string, 0, len(knownDiskTypes))
+	for t := range knownDiskTypes {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func coreFractionValues() []string {
+	return []string{"5", "20", "50", "100"}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhooks hosts the admission webhooks for this provider's API types. Keeping
+// them out of api/v1alpha1 means that package only needs plain apimachinery to register
+// its scheme, not controller-runtime's webhook/admission machinery, so consumers that only
+// want the types (e.g. clusterctl, other controllers) can do so without pulling it in.
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "github.com/shturval-tech/cluster-api-provider-yandex/api/v1alpha1"
+)
+
+// ymtlog is for logging in this webhook.
+var ymtlog = logf.Log.WithName("yandexmachinetemplate-resource")
+
+// regex for validating machine template webhook
+//   - It must start with a lowercase letter (a-z).
+//   - It can contain 0 to 55 (excluding the first and last character) additional characters, which may include lowercase letters (a-z), digits (0-9), and hyphens (-).
+//   - If additional characters are present, the string must end with a letter or a digit (it cannot end with a hyphen).
+//   - The 57-character limit exists because a YandexMachine name is generated from the YandexMachineTemplate name
+//     with a 6-character postfix (e.g., "-12345"), and the total allowed length is 63 characters.
+var nameRegex = regexp.MustCompile("^[a-z]([-a-z0-9]{0,55}[a-z0-9])?$")
+
+// mutableSpecPaths holds the spec-rooted, dot-separated paths (as rendered by field.Path.String(),
+// e.g. "spec.template.metadata.labels") that are allowed to change on update. Because diffSpec
+// checks this map before deciding whether to recurse, an entry here whitelists the whole subtree
+// under that path, not just a scalar leaf. It is empty today, but keeps diffSpec ready to opt future
+// fields in, e.g. "spec.template.metadata.labels", without changing the comparison strategy.
+var mutableSpecPaths = map[string]struct{}{}
+
+// YandexMachineTemplateWebhook implements admission.CustomValidator and admission.CustomDefaulter
+// for infrav1.YandexMachineTemplate.
+type YandexMachineTemplateWebhook struct{}
+
+var (
+	_ admission.CustomDefaulter = &YandexMachineTemplateWebhook{}
+	_ admission.CustomValidator = &YandexMachineTemplateWebhook{}
+)
+
+// SetupWebhookWithManager registers the YandexMachineTemplate validating and defaulting webhooks with the manager.
+func (w *YandexMachineTemplateWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&infrav1.YandexMachineTemplate{}).
+		WithValidator(w).
+		WithDefaulter(w).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha1-yandexmachinetemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=yandexmachinetemplates,verbs=create;update,versions=v1alpha1,name=validation.yandexmachinetemplates.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1beta1
+//+kubebuilder:webhook:path=/mutate-infrastructure-cluster-x-k8s-io-v1alpha1-yandexmachinetemplate,mutating=true,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=yandexmachinetemplates,verbs=create;update,versions=v1alpha1,name=default.yandexmachinetemplates.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1beta1
+
+// Default implements admission.CustomDefaulter.
+func (w *YandexMachineTemplateWebhook) Default(_ context.Context, obj runtime.Object) error {
+	t, ok := obj.(*infrav1.YandexMachineTemplate)
+	if !ok {
+		return fmt.Errorf("expected a YandexMachineTemplate but got %T", obj)
+	}
+
+	ymtlog.Info("default", "name", t.Name)
+	return nil
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (w *YandexMachineTemplateWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	t, ok := obj.(*infrav1.YandexMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a YandexMachineTemplate but got %T", obj)
+	}
+	ymtlog.Info("validate create", "name", t.Name)
+
+	var allErrs field.ErrorList
+
+	if t.Spec.Template.Spec.ProviderID != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "providerID"), "cannot be set in templates"))
+	}
+
+	allErrs = append(allErrs, validateYandexMachineSpec(t.Spec.Template.Spec, field.NewPath("spec", "template", "spec"))...)
+
+	if t.Spec.NamingStrategy != nil && t.Spec.NamingStrategy.Template != nil {
+		if err := infrav1.ValidateNamingStrategyTemplate(*t.Spec.NamingStrategy.Template); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec", "namingStrategy", "template"),
+				*t.Spec.NamingStrategy.Template,
+				err.Error()),
+			)
+		}
+	} else if !nameRegex.MatchString(t.Name) {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("metadata", "name"),
+			t.Name,
+			"may contain lowercase Latin letters, digits, and hyphens. The first character must be a letter, and the hyphen cannot be the last character, max 57 symbols"),
+		)
+	}
+
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+
+	return nil, apierrors.NewInvalid(infrav1.GroupVersion.WithKind("YandexMachineTemplate").GroupKind(), t.Name, allErrs)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (w *YandexMachineTemplateWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	t, ok := newObj.(*infrav1.YandexMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a YandexMachineTemplate but got %T", newObj)
+	}
+	old, ok := oldObj.(*infrav1.YandexMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a YandexMachineTemplate but got %T", oldObj)
+	}
+	ymtlog.Info("validate update", "name", t.Name)
+
+	newYandexMachineTemplate, err := runtime.DefaultUnstructuredConverter.ToUnstructured(t)
+	if err != nil {
+		return nil, apierrors.NewInvalid(infrav1.GroupVersion.WithKind("YandexMachineTemplate").GroupKind(), t.Name, field.ErrorList{
+			field.InternalError(nil, errors.Wrap(err, "failed to convert new YandexMachineTemplate to unstructured object")),
+		})
+	}
+	oldYandexMachineTemplate, err := runtime.DefaultUnstructuredConverter.ToUnstructured(old)
+	if err != nil {
+		return nil, apierrors.NewInvalid(infrav1.GroupVersion.WithKind("YandexMachineTemplate").GroupKind(), t.Name, field.ErrorList{
+			field.InternalError(nil, errors.Wrap(err, "failed to convert old YandexMachineTemplate to unstructured object")),
+		})
+	}
+
+	newYandexMachineTemplateSpec := newYandexMachineTemplate["spec"].(map[string]interface{})
+	oldYandexMachineTemplateSpec := oldYandexMachineTemplate["spec"].(map[string]interface{})
+
+	if allErrs := diffSpec(field.NewPath("spec"), oldYandexMachineTemplateSpec, newYandexMachineTemplateSpec); len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(infrav1.GroupVersion.WithKind("YandexMachineTemplate").GroupKind(), t.Name, allErrs)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (w *YandexMachineTemplateWebhook) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	t, ok := obj.(*infrav1.YandexMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a YandexMachineTemplate but got %T", obj)
+	}
+	ymtlog.Info("validate delete", "name", t.Name)
+
+	return nil, nil
+}
+
+// diffSpec walks oldVal/newVal recursively and returns a field.Forbidden error for every path
+// whose value changed, unless that path (or an ancestor of it) is in mutableSpecPaths. The
+// mutable-path check runs before the map branch recurses, so whitelisting a path short-circuits
+// the whole subtree under it rather than just a scalar leaf. Map keys are visited in sorted order
+// so the returned error list is deterministic.
+func diffSpec(path *field.Path, oldVal, newVal interface{}) field.ErrorList {
+	if reflect.DeepEqual(oldVal, newVal) {
+		return nil
+	}
+
+	if _, mutable := mutableSpecPaths[path.String()]; mutable {
+		return nil
+	}
+
+	oldMap, oldIsMap := oldVal.(map[string]interface{})
+	newMap, newIsMap := newVal.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		keySet := make(map[string]struct{}, len(oldMap)+len(newMap))
+		for k := range oldMap {
+			keySet[k] = struct{}{}
+		}
+		for k := range newMap {
+			keySet[k] = struct{}{}
+		}
+
+		keys := make([]string, 0, len(keySet))
+		for k := range keySet {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var allErrs field.ErrorList
+		for _, k := range keys {
+			allErrs = append(allErrs, diffSpec(path.Child(k), oldMap[k], newMap[k])...)
+		}
+		return allErrs
+	}
+
+	return field.ErrorList{field.Forbidden(path, "cannot be modified")}
+}